@@ -2,19 +2,80 @@ package serializers
 
 import (
 	"fmt"
+	"io"
 	"time"
 
 	"github.com/influxdata/telegraf"
+	"github.com/influxdata/telegraf/plugins/serializers/graphite"
+	"github.com/influxdata/telegraf/plugins/serializers/influx"
+	"github.com/influxdata/telegraf/plugins/serializers/json"
 	"github.com/influxdata/telegraf/plugins/serializers/mdm"
+	"github.com/influxdata/telegraf/plugins/serializers/prometheus"
+	"github.com/influxdata/telegraf/plugins/serializers/splunkmetric"
+	"github.com/influxdata/telegraf/plugins/serializers/wavefront"
 )
 
 // SerializerOutput is an interface for output plugins that are able to
 // serialize telegraf metrics into arbitrary data formats.
+//
+// Implementations should write a batch via the package-level Write function
+// rather than calling SerializeBatch and writing the result themselves, so
+// that they automatically pick up a serializer's streaming SerializeWriter
+// path when one is available.
+//
+// This source tree does not currently contain any in-tree output plugins,
+// so there are no SerializerOutput implementations here to update; the
+// guidance above applies to output plugins elsewhere in the module.
 type SerializerOutput interface {
 	// SetSerializer sets the serializer function for the interface.
 	SetSerializer(serializer Serializer)
 }
 
+// SerializerWriter is an optional interface that a Serializer may implement
+// to stream its output directly to an io.Writer instead of buffering the
+// whole batch in a []byte.  Serializers that produce large batches (mdm,
+// prometheus, splunkmetric) should implement this to avoid the allocation.
+//
+// NewSerializer wraps any Serializer that does not implement this interface
+// with an adapter based on SerializeBatch, so callers can always type-assert
+// for SerializerWriter and fall back to Write if the assertion fails.
+type SerializerWriter interface {
+	// SerializeWriter writes a batch of metrics, in the serializer's data
+	// format, to w.
+	SerializeWriter(w io.Writer, metrics []telegraf.Metric) error
+}
+
+// Write serializes metrics and writes them to w, preferring the streaming
+// SerializerWriter path when the serializer supports it.
+func Write(w io.Writer, serializer Serializer, metrics []telegraf.Metric) error {
+	if sw, ok := serializer.(SerializerWriter); ok {
+		return sw.SerializeWriter(w, metrics)
+	}
+
+	buf, err := serializer.SerializeBatch(metrics)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(buf)
+	return err
+}
+
+// serializerWriterAdapter adapts a Serializer that does not natively support
+// streaming to the SerializerWriter interface by buffering a single
+// SerializeBatch call.
+type serializerWriterAdapter struct {
+	Serializer
+}
+
+func (s *serializerWriterAdapter) SerializeWriter(w io.Writer, metrics []telegraf.Metric) error {
+	buf, err := s.SerializeBatch(metrics)
+	if err != nil {
+		return err
+	}
+	_, err = w.Write(buf)
+	return err
+}
+
 // Serializer is an interface defining functions that a serializer plugin must
 // satisfy.
 //
@@ -89,17 +150,104 @@ type Config struct {
 	PrometheusStringAsLabel bool `toml:"prometheus_string_as_label"`
 }
 
-// NewSerializer a Serializer interface based on the given config.
+// Factory creates a Serializer from the given Config.  Out-of-tree
+// serializers can register their own factory via Add from an init()
+// function in their own package, without needing to modify this file.
+type Factory func(config *Config) (Serializer, error)
+
+// serializers is the registry of known data formats, keyed by the name used
+// in the data_format config option.
+var serializers = map[string]Factory{}
+
+// Add registers a serializer factory under the given name so that it can be
+// selected via the data_format config option.
+func Add(name string, factory Factory) {
+	serializers[name] = factory
+}
+
+func init() {
+	Add("influx", NewInfluxSerializerConfig)
+	Add("graphite", func(config *Config) (Serializer, error) {
+		return NewGraphiteSerializer(config.Prefix, config.Template, config.GraphiteTagSupport)
+	})
+	Add("json", func(config *Config) (Serializer, error) {
+		return NewJSONSerializer(config.TimestampUnits)
+	})
+	Add("splunkmetric", func(config *Config) (Serializer, error) {
+		return NewSplunkmetricSerializer(config.HecRouting, config.SplunkmetricMultiMetric)
+	})
+	Add("wavefront", func(config *Config) (Serializer, error) {
+		return NewWavefrontSerializer(config.Prefix, config.WavefrontUseStrict, config.WavefrontSourceOverride)
+	})
+	Add("prometheus", NewPrometheusSerializer)
+	Add("mdm", func(config *Config) (Serializer, error) {
+		return NewMdmSerializer()
+	})
+}
+
+// NewSerializer a Serializer interface based on the given config.  The
+// returned Serializer also implements SerializerWriter, either natively or
+// via an adapter, so callers can always use the streaming path.
+//
+// The accepted data formats are the names registered via Add: the built-in
+// influx, graphite, json, splunkmetric, wavefront, prometheus and mdm, plus
+// any added by other packages.  Any other name returns an error.
 func NewSerializer(config *Config) (Serializer, error) {
-	var err error
-	var serializer Serializer
-	switch config.DataFormat {
-	case "mdm":
-		serializer, err = NewMdmSerializer()
-	default:
-		err = fmt.Errorf("Invalid data format: %s", config.DataFormat)
+	factory, ok := serializers[config.DataFormat]
+	if !ok {
+		return nil, fmt.Errorf("Invalid data format: %s", config.DataFormat)
 	}
-	return serializer, err
+
+	serializer, err := factory(config)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := serializer.(SerializerWriter); ok {
+		return serializer, nil
+	}
+	return &serializerWriterAdapter{serializer}, nil
+}
+
+func NewInfluxSerializerConfig(config *Config) (Serializer, error) {
+	s := influx.NewSerializer()
+	if config.InfluxMaxLineBytes > 0 {
+		s.SetMaxLineBytes(config.InfluxMaxLineBytes)
+	}
+
+	if config.InfluxSortFields {
+		s.SetFieldSortOrder(influx.SortFields)
+	}
+
+	if config.InfluxUintSupport {
+		s.SetUintSupport(true)
+	}
+
+	return s, nil
+}
+
+func NewGraphiteSerializer(prefix, template string, graphiteTagSupport bool) (Serializer, error) {
+	return graphite.NewGraphiteSerializer(prefix, template, graphiteTagSupport)
+}
+
+func NewJSONSerializer(timestampUnits time.Duration) (Serializer, error) {
+	return json.NewSerializer(timestampUnits)
+}
+
+func NewSplunkmetricSerializer(hecRouting bool, splunkmetricMultiMetric bool) (Serializer, error) {
+	return splunkmetric.NewSerializer(hecRouting, splunkmetricMultiMetric)
+}
+
+func NewWavefrontSerializer(prefix string, useStrict bool, sourceOverride []string) (Serializer, error) {
+	return wavefront.NewSerializer(prefix, useStrict, sourceOverride)
+}
+
+func NewPrometheusSerializer(config *Config) (Serializer, error) {
+	return prometheus.NewSerializer(&prometheus.FormatConfig{
+		TimestampExport: config.PrometheusExportTimestamp,
+		MetricSortOrder: config.PrometheusSortMetrics,
+		StringAsLabel:   config.PrometheusStringAsLabel,
+	})
 }
 
 func NewMdmSerializer() (Serializer, error) {
@@ -0,0 +1,40 @@
+package serializers
+
+import (
+	"testing"
+
+	"github.com/influxdata/telegraf"
+)
+
+type stubSerializer struct{}
+
+func (stubSerializer) Serialize(metric telegraf.Metric) ([]byte, error) {
+	return nil, nil
+}
+
+func (stubSerializer) SerializeBatch(metrics []telegraf.Metric) ([]byte, error) {
+	return nil, nil
+}
+
+func TestAddRegistersDataFormat(t *testing.T) {
+	const name = "registry-test-format"
+	want := &stubSerializer{}
+
+	Add(name, func(config *Config) (Serializer, error) {
+		return want, nil
+	})
+	defer delete(serializers, name)
+
+	got, err := NewSerializer(&Config{DataFormat: name})
+	if err != nil {
+		t.Fatalf("NewSerializer(%q) returned error: %v", name, err)
+	}
+
+	adapter, ok := got.(*serializerWriterAdapter)
+	if !ok {
+		t.Fatalf("NewSerializer(%q) = %T, want *serializerWriterAdapter wrapping the factory registered via Add", name, got)
+	}
+	if adapter.Serializer != Serializer(want) {
+		t.Fatalf("NewSerializer(%q) did not return the Serializer registered via Add", name)
+	}
+}
@@ -0,0 +1,88 @@
+package serializers
+
+import (
+	"testing"
+	"time"
+)
+
+func TestNewSerializer(t *testing.T) {
+	tests := []struct {
+		name   string
+		config *Config
+	}{
+		{
+			name: "influx",
+			config: &Config{
+				DataFormat:         "influx",
+				InfluxMaxLineBytes: 1024,
+				InfluxSortFields:   true,
+				InfluxUintSupport:  true,
+			},
+		},
+		{
+			name: "graphite",
+			config: &Config{
+				DataFormat:         "graphite",
+				GraphiteTagSupport: true,
+				Prefix:             "telegraf",
+				Template:           "host.tags.measurement.field",
+			},
+		},
+		{
+			name: "json",
+			config: &Config{
+				DataFormat:     "json",
+				TimestampUnits: time.Second,
+			},
+		},
+		{
+			name: "splunkmetric",
+			config: &Config{
+				DataFormat:              "splunkmetric",
+				HecRouting:              true,
+				SplunkmetricMultiMetric: true,
+			},
+		},
+		{
+			name: "wavefront",
+			config: &Config{
+				DataFormat:              "wavefront",
+				Prefix:                  "telegraf",
+				WavefrontUseStrict:      true,
+				WavefrontSourceOverride: []string{"host"},
+			},
+		},
+		{
+			name: "prometheus",
+			config: &Config{
+				DataFormat:                "prometheus",
+				PrometheusExportTimestamp: true,
+				PrometheusSortMetrics:     true,
+				PrometheusStringAsLabel:   true,
+			},
+		},
+		{
+			name:   "mdm",
+			config: &Config{DataFormat: "mdm"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s, err := NewSerializer(tt.config)
+			if err != nil {
+				t.Fatalf("NewSerializer(%q) returned error: %v", tt.name, err)
+			}
+			if s == nil {
+				t.Fatalf("NewSerializer(%q) returned a nil Serializer", tt.name)
+			}
+		})
+	}
+}
+
+func TestNewSerializerInvalidDataFormat(t *testing.T) {
+	_, err := NewSerializer(&Config{DataFormat: "nonexistent"})
+	if err == nil {
+		t.Fatal("NewSerializer with an unregistered data format should return an error")
+	}
+}